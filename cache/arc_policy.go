@@ -0,0 +1,158 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// arcPolicy 自适应替换缓存（Adaptive Replacement Cache）淘汰策略。
+// 维护两条缓存队列：T1(最近只访问过一次)、T2(最近访问过多次)，
+// 以及两条幽灵队列：B1(最近从T1淘汰)、B2(最近从T2淘汰)，只记录key不记录value。
+// 根据幽灵队列的命中情况动态调整目标大小p，在扫描型负载和热点型负载之间自适应。
+type arcPolicy[K comparable] struct {
+	mu sync.Mutex
+	c  int // 缓存容量
+	p  int // T1的目标大小，取值范围[0, c]
+
+	t1, t2, b1, b2     *list.List
+	t1m, t2m, b1m, b2m map[K]*list.Element
+}
+
+// NewARCPolicy 生成ARC淘汰策略，c为缓存容量（需与Cache的maxCacheLen一致）
+func NewARCPolicy[K comparable](c int) EvictionPolicy[K] {
+	if c < 1 {
+		c = 1
+	}
+	return &arcPolicy[K]{
+		c:   c,
+		t1:  list.New(),
+		t2:  list.New(),
+		b1:  list.New(),
+		b2:  list.New(),
+		t1m: make(map[K]*list.Element),
+		t2m: make(map[K]*list.Element),
+		b1m: make(map[K]*list.Element),
+		b2m: make(map[K]*list.Element),
+	}
+}
+
+// OnAccess 命中T1中的key视为再次访问，提升进入T2；命中T2只需前移
+func (a *arcPolicy[K]) OnAccess(k K) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if e, ok := a.t1m[k]; ok {
+		a.t1.Remove(e)
+		delete(a.t1m, k)
+		a.t2m[k] = a.t2.PushFront(k)
+		return
+	}
+	if e, ok := a.t2m[k]; ok {
+		a.t2.MoveToFront(e)
+	}
+}
+
+// OnAdd 处理一次写入：命中幽灵队列时据此调整p并将key提升为T2，否则作为全新key进入T1
+func (a *arcPolicy[K]) OnAdd(k K) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if e, ok := a.t1m[k]; ok {
+		a.t1.Remove(e)
+		delete(a.t1m, k)
+		a.t2m[k] = a.t2.PushFront(k)
+		return
+	}
+	if e, ok := a.t2m[k]; ok {
+		a.t2.MoveToFront(e)
+		return
+	}
+	if e, ok := a.b1m[k]; ok {
+		delta := 1
+		if len(a.b2m) > len(a.b1m) {
+			delta = len(a.b2m) / len(a.b1m)
+		}
+		a.p = arcMin(a.c, a.p+delta)
+		a.b1.Remove(e)
+		delete(a.b1m, k)
+		a.t2m[k] = a.t2.PushFront(k)
+		return
+	}
+	if e, ok := a.b2m[k]; ok {
+		delta := 1
+		if len(a.b1m) > len(a.b2m) {
+			delta = len(a.b1m) / len(a.b2m)
+		}
+		a.p = arcMax(0, a.p-delta)
+		a.b2.Remove(e)
+		delete(a.b2m, k)
+		a.t2m[k] = a.t2.PushFront(k)
+		return
+	}
+	a.t1m[k] = a.t1.PushFront(k)
+}
+
+func (a *arcPolicy[K]) OnRemove(k K) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if e, ok := a.t1m[k]; ok {
+		a.t1.Remove(e)
+		delete(a.t1m, k)
+		return
+	}
+	if e, ok := a.t2m[k]; ok {
+		a.t2.Remove(e)
+		delete(a.t2m, k)
+	}
+}
+
+// Evict 按|T1|与p的关系在T1/T2之间选择淘汰对象，被淘汰的key移入对应幽灵队列
+func (a *arcPolicy[K]) Evict() (K, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var zero K
+	if a.t1.Len() == 0 && a.t2.Len() == 0 {
+		return zero, false
+	}
+
+	if a.t1.Len() > 0 && (a.t1.Len() > a.p || (a.t1.Len() == a.p && a.t2.Len() == 0)) {
+		e := a.t1.Back()
+		k := e.Value.(K)
+		a.t1.Remove(e)
+		delete(a.t1m, k)
+		a.b1m[k] = a.b1.PushFront(k)
+		a.trimGhost(a.b1, a.b1m)
+		return k, true
+	}
+
+	e := a.t2.Back()
+	k := e.Value.(K)
+	a.t2.Remove(e)
+	delete(a.t2m, k)
+	a.b2m[k] = a.b2.PushFront(k)
+	a.trimGhost(a.b2, a.b2m)
+	return k, true
+}
+
+// trimGhost 保持幽灵队列不超过缓存容量，防止无限增长
+func (a *arcPolicy[K]) trimGhost(l *list.List, m map[K]*list.Element) {
+	for l.Len() > a.c {
+		e := l.Back()
+		delete(m, e.Value.(K))
+		l.Remove(e)
+	}
+}
+
+func arcMin(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func arcMax(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,102 @@
+package cache
+
+import "testing"
+
+// TestARCPolicyT1PromotesToT2OnAccess 验证T1中的key被再次访问(OnAccess)后
+// 会提升进入T2，而不是继续留在T1中。
+func TestARCPolicyT1PromotesToT2OnAccess(t *testing.T) {
+	a := NewARCPolicy[string](4).(*arcPolicy[string])
+
+	a.OnAdd("a")
+	if _, ok := a.t1m["a"]; !ok {
+		t.Fatalf("expected a in T1 after first OnAdd")
+	}
+
+	a.OnAccess("a")
+	if _, ok := a.t1m["a"]; ok {
+		t.Fatalf("expected a removed from T1 after OnAccess")
+	}
+	if _, ok := a.t2m["a"]; !ok {
+		t.Fatalf("expected a promoted into T2 after OnAccess")
+	}
+}
+
+// TestARCPolicyGhostHitB1IncreasesP 验证命中B1幽灵队列时，目标大小p会增大，
+// 且该key被直接提升进入T2而不是重新计入T1。
+func TestARCPolicyGhostHitB1IncreasesP(t *testing.T) {
+	a := NewARCPolicy[string](4).(*arcPolicy[string])
+
+	// 填满T1并触发一次淘汰，使"a"进入B1幽灵队列
+	a.OnAdd("a")
+	a.OnAdd("b")
+	a.OnAdd("c")
+	a.OnAdd("d")
+	k, ok := a.Evict()
+	if !ok || k != "a" {
+		t.Fatalf("expected a to be evicted from T1 into B1, got %v, %v", k, ok)
+	}
+	if _, ok := a.b1m["a"]; !ok {
+		t.Fatalf("expected a in B1 after eviction")
+	}
+
+	pBefore := a.p
+	a.OnAdd("a")
+	if a.p <= pBefore {
+		t.Fatalf("expected p to increase after B1 ghost hit, before=%d after=%d", pBefore, a.p)
+	}
+	if _, ok := a.b1m["a"]; ok {
+		t.Fatalf("expected a removed from B1 after ghost hit")
+	}
+	if _, ok := a.t2m["a"]; !ok {
+		t.Fatalf("expected a promoted directly into T2 after B1 ghost hit")
+	}
+}
+
+// TestARCPolicyGhostHitB2DecreasesP 验证命中B2幽灵队列时，目标大小p会减小，
+// 对称于B1命中使p增大的行为。
+func TestARCPolicyGhostHitB2DecreasesP(t *testing.T) {
+	a := NewARCPolicy[string](4).(*arcPolicy[string])
+
+	a.OnAdd("a")
+	a.OnAccess("a") // 提升进T2
+	a.OnAdd("b")
+	a.OnAdd("c")
+	a.OnAdd("d")
+	a.p = 3 // 人为抬高p，确保Evict从T2中选择而不是T1
+
+	k, ok := a.Evict()
+	if !ok || k != "a" {
+		t.Fatalf("expected a to be evicted from T2 into B2, got %v, %v", k, ok)
+	}
+	if _, ok := a.b2m["a"]; !ok {
+		t.Fatalf("expected a in B2 after eviction")
+	}
+
+	pBefore := a.p
+	a.OnAdd("a")
+	if a.p >= pBefore {
+		t.Fatalf("expected p to decrease after B2 ghost hit, before=%d after=%d", pBefore, a.p)
+	}
+	if _, ok := a.t2m["a"]; !ok {
+		t.Fatalf("expected a promoted directly into T2 after B2 ghost hit")
+	}
+}
+
+// TestARCPolicyEvictFromT2WhenT1WithinTarget 验证当T1长度未超过目标p时，
+// Evict应从T2中选择淘汰对象。
+func TestARCPolicyEvictFromT2WhenT1WithinTarget(t *testing.T) {
+	a := NewARCPolicy[string](4).(*arcPolicy[string])
+
+	a.OnAdd("a")
+	a.OnAccess("a") // a进入T2
+	a.OnAdd("b")    // b留在T1
+	a.p = 2         // T1长度(1) <= p(2)，且T2非空，应从T2淘汰
+
+	k, ok := a.Evict()
+	if !ok || k != "a" {
+		t.Fatalf("expected a (T2) to be evicted, got %v, %v", k, ok)
+	}
+	if _, ok := a.b2m["a"]; !ok {
+		t.Fatalf("expected evicted T2 entry to land in B2")
+	}
+}
@@ -2,126 +2,207 @@ package cache
 
 import (
 	"fmt"
-	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/singleflight"
 )
 
-// NewCache 根据输入缓存长度生成Cache对象
-func NewCache[T1, T2 comparable](maxCacheLen uint16, outTime time.Duration) *Cache[T1, T2] {
+// NewCache 根据输入缓存长度生成Cache对象，默认使用LRU淘汰策略，
+// 可通过WithPolicy传入其他EvictionPolicy实现（如LFU、FIFO、ARC）。
+// outTime>0时会启动一个后台时间轮goroutine，调用方在不再使用该Cache后必须调用Close。
+func NewCache[T1, T2 comparable](maxCacheLen uint16, outTime time.Duration, opts ...Option[T1]) *Cache[T1, T2] {
 	if maxCacheLen < 3 {
 		maxCacheLen = 3
 	}
-	return &Cache[T1, T2]{
+	o := newCacheOptions(opts...)
+	c := &Cache[T1, T2]{
 		maxCacheLen: int(maxCacheLen),
 		m:           make(map[T1]T2, maxCacheLen),
-		outTimer:    make(map[T1]*time.Timer),
-		lastTime:    make(map[T1]int64, maxCacheLen),
+		policy:      o.policy,
 		outTime:     outTime,
 	}
+	if o.lrukK > 0 {
+		historyLimit := o.lrukHistoryLimit
+		if historyLimit <= 0 {
+			historyLimit = int(maxCacheLen)
+		}
+		c.history = newLRUKHistory[T1, T2](o.lrukK, historyLimit)
+	}
+	if o.onEvicted != nil {
+		if fn, ok := o.onEvicted.(func(T1, T2, EvictReason)); ok {
+			c.onEvicted = fn
+		}
+	}
+	c.jitterFraction = o.jitterFraction
+	if outTime > 0 {
+		c.gen = make(map[T1]uint64, maxCacheLen)
+		c.wheel = newTimingWheel[T1](o.tickDuration, o.wheelSize, c.expire)
+	}
+	return c
 }
 
 // 适用场景: 读多写少
-// - 支持过期时间
+// - 支持过期时间，过期淘汰基于共享的哈希时间轮，O(1)挂载
 // - 单个value自动扩容
-// - 淘汰机制LRU
+// - 淘汰机制可插拔，默认LRU，O(1)访问/淘汰
 // - 锁机制：读写锁
 
-// Cache 一个可并发、防击穿的LRU算法
-// 最小长度缓存为1，达到缓存上限时，淘汰最久未访问的数据
+// Cache 一个可并发、防击穿、淘汰策略可插拔的缓存
+// 最小长度缓存为3，达到缓存上限时，按当前EvictionPolicy淘汰数据
 // 直接使用时，自动使用最小缓存长度
 type Cache[T1, T2 comparable] struct {
-	gmutex, lmutex sync.RWMutex
-	tmutex         sync.Mutex
-	outTimer       map[T1]*time.Timer
-	lastTime       map[T1]int64
-	m              map[T1]T2          // 数据
+	gmutex         sync.RWMutex
+	m              map[T1]T2
+	policy         EvictionPolicy[T1]
+	history        *lrukHistory[T1, T2] // 非nil时开启LRU-K准入
+	wheel          *timingWheel[T1]     // 非nil时开启过期淘汰
+	gen            map[T1]uint64        // 每个key当前的数据版本号，用于核实到期触发未被后续Store抢先刷新
+	onEvicted      func(T1, T2, EvictReason)
 	g              singleflight.Group // 防击穿
 	maxCacheLen    int
 	outTime        time.Duration
+	jitterFraction float64
+
+	hits, misses, evictions, expirations, dedups uint64
 }
 
 // Delete 根据key主动删除缓存
 func (c *Cache[T1, T2]) Delete(k T1) {
 	c.gmutex.Lock()
+	v, ok := c.m[k]
 	delete(c.m, k)
+	delete(c.gen, k)
 	c.gmutex.Unlock()
 
-	c.lmutex.Lock()
-	delete(c.lastTime, k)
-	c.lmutex.Unlock()
-	go runtime.GC()
+	c.policy.OnRemove(k)
+	if c.history != nil {
+		c.history.remove(k)
+	}
+	if c.wheel != nil {
+		c.wheel.Remove(k)
+	}
+	if ok && c.onEvicted != nil {
+		c.onEvicted(k, v, EvictManual)
+	}
 }
 
-func (c *Cache[T1, T2]) Clear() {
+// expire 由时间轮在key的TTL到期时调用。gen是该条到期挂载建立时的数据版本号；
+// 如果key在此之后又被Store刷新过（版本号已经前进），说明这次到期针对的是
+// 已经作废的旧挂载，必须放弃删除，否则会把刚刷新的新值误删掉。
+func (c *Cache[T1, T2]) expire(k T1, gen uint64) {
 	c.gmutex.Lock()
-	for k := range c.m {
-		delete(c.m, k)
+	if g, ok := c.gen[k]; !ok || g != gen {
+		c.gmutex.Unlock()
+		return
 	}
+	v, ok := c.m[k]
+	delete(c.m, k)
+	delete(c.gen, k)
 	c.gmutex.Unlock()
-	c.lmutex.Lock()
-	for k := range c.lastTime {
-		delete(c.lastTime, k)
+
+	c.policy.OnRemove(k)
+	if c.history != nil {
+		c.history.remove(k)
+	}
+	if ok {
+		atomic.AddUint64(&c.expirations, 1)
+		if c.onEvicted != nil {
+			c.onEvicted(k, v, EvictExpired)
+		}
 	}
-	c.lmutex.Unlock()
-	go runtime.GC()
 }
 
-func (c *Cache[T1, T2]) updateTime(k T1) {
-	c.lmutex.Lock()
-	c.lastTime[k] = time.Now().Unix()
-	c.lmutex.Unlock()
+func (c *Cache[T1, T2]) Clear() {
+	c.gmutex.Lock()
+	old := c.m
+	c.m = make(map[T1]T2, c.maxCacheLen)
+	if c.gen != nil {
+		c.gen = make(map[T1]uint64, c.maxCacheLen)
+	}
+	c.gmutex.Unlock()
+
+	for k := range old {
+		c.policy.OnRemove(k)
+	}
+	if c.history != nil {
+		c.history.clear()
+	}
+	if c.wheel != nil {
+		c.wheel.Clear()
+	}
+	if c.onEvicted != nil {
+		for k, v := range old {
+			c.onEvicted(k, v, EvictClear)
+		}
+	}
 }
 
 // Store 存储key-value数据
 func (c *Cache[T1, T2]) Store(key T1, data T2) {
-	var minKey T1
-	needOut := false
-	c.lmutex.RLock()
-	if _, ok := c.lastTime[key]; !ok {
-		if len(c.lastTime) >= c.maxCacheLen {
-			needOut = true
-			minTime := time.Now().Unix()
-			for k, v := range c.lastTime {
-				if v < minTime {
-					minKey = k
-					minTime = v
-				}
+	if c.history != nil {
+		c.gmutex.RLock()
+		_, exists := c.m[key]
+		c.gmutex.RUnlock()
+		if !exists {
+			v, promote := c.history.touch(key, data)
+			if !promote {
+				return
 			}
+			data = v
+		} else {
+			c.history.remove(key)
 		}
 	}
-	c.lmutex.RUnlock()
+
+	var evictedKey T1
+	var evictedVal T2
+	evicted := false
+	var gen uint64
 
 	c.gmutex.Lock()
+	if _, ok := c.m[key]; !ok && len(c.m) >= c.maxCacheLen {
+		if ek, ok := c.policy.Evict(); ok {
+			if v, exists := c.m[ek]; exists {
+				evictedKey, evictedVal, evicted = ek, v, true
+				delete(c.m, ek)
+				delete(c.gen, ek)
+			}
+		}
+	}
 	c.m[key] = data
+	if c.gen != nil {
+		c.gen[key]++
+		gen = c.gen[key]
+	}
 	c.gmutex.Unlock()
-	if c.outTime > 0 {
-		go func(key T1) {
-			c.tmutex.Lock()
-			if v, ok := c.outTimer[key]; ok {
-				v.Reset(c.outTime / 2)
-			} else {
-				c.outTimer[key] = time.AfterFunc(c.outTime, func() {
-					c.Delete(key)
-				})
-			}
-			c.tmutex.Unlock()
-		}(key)
+	c.policy.OnAdd(key)
+
+	if evicted {
+		atomic.AddUint64(&c.evictions, 1)
+		if c.wheel != nil {
+			c.wheel.Remove(evictedKey)
+		}
+		if c.onEvicted != nil {
+			c.onEvicted(evictedKey, evictedVal, EvictLRU)
+		}
 	}
-	if needOut {
-		c.Delete(minKey)
+
+	if c.wheel != nil {
+		c.wheel.Add(key, jitterDuration(c.outTime, c.jitterFraction), gen)
 	}
-	go c.updateTime(key)
 }
 
 func (c *Cache[T1, T2]) Load(k T1) (T2, bool) {
 	c.gmutex.RLock()
-	defer c.gmutex.RUnlock()
 	v, ok := c.m[k]
+	c.gmutex.RUnlock()
 	if ok {
-		go c.updateTime(k)
+		atomic.AddUint64(&c.hits, 1)
+		c.policy.OnAccess(k)
+	} else {
+		atomic.AddUint64(&c.misses, 1)
 	}
 	return v, ok
 }
@@ -132,12 +213,38 @@ func (c *Cache[T1, T2]) LoadOrStore(k T1, fu func() (T2, error)) (T2, error) {
 		return v, nil
 	}
 
-	res, err, _ := c.g.Do(fmt.Sprint(k), func() (interface{}, error) {
+	res, err, shared := c.g.Do(fmt.Sprint(k), func() (interface{}, error) {
 		return fu()
 	})
+	if shared {
+		atomic.AddUint64(&c.dedups, 1)
+	}
 	v, ok := res.(T2)
 	if ok && err == nil {
 		c.Store(k, v)
 	}
 	return v, err
 }
+
+// Close 停止该Cache持有的过期时间轮后台goroutine。未开启TTL（outTime<=0）时是空操作。
+// 开启了TTL的Cache在不再使用后必须调用Close，否则时间轮的后台goroutine不会退出，造成泄漏。
+func (c *Cache[T1, T2]) Close() {
+	if c.wheel != nil {
+		c.wheel.Stop()
+	}
+}
+
+// Stats 返回当前命中率、淘汰等运行时指标的快照
+func (c *Cache[T1, T2]) Stats() Stats {
+	c.gmutex.RLock()
+	size := len(c.m)
+	c.gmutex.RUnlock()
+	return Stats{
+		Hits:        atomic.LoadUint64(&c.hits),
+		Misses:      atomic.LoadUint64(&c.misses),
+		Evictions:   atomic.LoadUint64(&c.evictions),
+		Expirations: atomic.LoadUint64(&c.expirations),
+		Dedups:      atomic.LoadUint64(&c.dedups),
+		Size:        size,
+	}
+}
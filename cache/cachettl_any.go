@@ -1,140 +1,247 @@
-package cache
-
-import (
-	"fmt"
-	"runtime"
-	"sync"
-	"time"
-
-	"golang.org/x/sync/singleflight"
-)
-
-// NewCache 根据输入缓存长度生成AnyCache对象
-func NewAnyCache[T comparable](maxCacheLen uint16, outTime time.Duration) *AnyCache[T] {
-	if maxCacheLen < 3 {
-		maxCacheLen = 3
-	}
-	return &AnyCache[T]{
-		maxCacheLen: int(maxCacheLen),
-		m:           make(map[T]any, maxCacheLen),
-		outTimer:    make(map[T]*time.Timer),
-		lastTime:    make(map[T]int64, maxCacheLen),
-		outTime:     outTime,
-	}
-}
-
-// 适用场景: 读多写少
-// - 支持过期时间
-// - 单个value自动扩容
-// - 淘汰机制LRU
-// - 锁机制：读写锁
-
-// Cache 一个可并发、防击穿的LRU算法
-// 最小长度缓存为1，达到缓存上限时，淘汰最久未访问的数据
-// 直接使用时，自动使用最小缓存长度
-type AnyCache[T comparable] struct {
-	gmutex, lmutex sync.RWMutex
-	tmutex         sync.Mutex
-	outTimer       map[T]*time.Timer
-	lastTime       map[T]int64
-	m              map[T]any          // 数据
-	g              singleflight.Group // 防击穿
-	maxCacheLen    int
-	outTime        time.Duration
-}
-
-// Delete 根据key主动删除缓存
-func (c *AnyCache[T]) Delete(k T) {
-	c.gmutex.Lock()
-	delete(c.m, k)
-	c.gmutex.Unlock()
-
-	c.lmutex.Lock()
-	delete(c.lastTime, k)
-	c.lmutex.Unlock()
-	go runtime.GC()
-}
-
-func (c *AnyCache[T]) Clear() {
-	c.gmutex.Lock()
-	for k := range c.m {
-		delete(c.m, k)
-	}
-	c.gmutex.Unlock()
-	c.lmutex.Lock()
-	for k := range c.lastTime {
-		delete(c.lastTime, k)
-	}
-	c.lmutex.Unlock()
-	go runtime.GC()
-}
-
-func (c *AnyCache[T]) updateTime(k T) {
-	c.lmutex.Lock()
-	c.lastTime[k] = time.Now().Unix()
-	c.lmutex.Unlock()
-}
-
-// Store 存储key-value数据
-func (c *AnyCache[T]) Store(key T, data any) {
-	var minKey T
-	needOut := false
-	c.lmutex.RLock()
-	if _, ok := c.lastTime[key]; !ok {
-		if len(c.lastTime) >= c.maxCacheLen {
-			needOut = true
-			minTime := time.Now().Unix()
-			for k, v := range c.lastTime {
-				if v < minTime {
-					minKey = k
-					minTime = v
-				}
-			}
-		}
-	}
-	c.lmutex.RUnlock()
-
-	c.gmutex.Lock()
-	c.m[key] = data
-	c.gmutex.Unlock()
-	if c.outTime > 0 {
-		go func(key T) {
-			c.tmutex.Lock()
-			if v, ok := c.outTimer[key]; ok {
-				v.Reset(c.outTime / 2)
-			} else {
-				c.outTimer[key] = time.AfterFunc(c.outTime, func() {
-					c.Delete(key)
-				})
-			}
-			c.tmutex.Unlock()
-		}(key)
-	}
-	if needOut {
-		c.Delete(minKey)
-	}
-	go c.updateTime(key)
-}
-
-func (c *AnyCache[T]) Load(k T) (any, bool) {
-	c.gmutex.RLock()
-	defer c.gmutex.RUnlock()
-	if v, ok := c.m[k]; ok {
-		go c.updateTime(k)
-		return v, true
-	}
-	return nil, false
-}
-
-// LoadOrStore 根据key读取数据，当没有数据时，根据输入的方法存储并返回数据
-func (c *AnyCache[T]) LoadOrStore(k T, fu func() (any, error)) (any, error) {
-	if v, ok := c.Load(k); ok {
-		return v, nil
-	}
-
-	res, err, _ := c.g.Do(fmt.Sprint(k), fu)
-	if err == nil {
-		c.Store(k, res)
-	}
-	return res, err
-}
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// NewAnyCache 根据输入缓存长度生成AnyCache对象，默认使用LRU淘汰策略，
+// 可通过WithPolicy传入其他EvictionPolicy实现（如LFU、FIFO、ARC）。
+// outTime>0时会启动一个后台时间轮goroutine，调用方在不再使用该AnyCache后必须调用Close。
+func NewAnyCache[T comparable](maxCacheLen uint16, outTime time.Duration, opts ...Option[T]) *AnyCache[T] {
+	if maxCacheLen < 3 {
+		maxCacheLen = 3
+	}
+	o := newCacheOptions(opts...)
+	c := &AnyCache[T]{
+		maxCacheLen: int(maxCacheLen),
+		m:           make(map[T]any, maxCacheLen),
+		policy:      o.policy,
+		outTime:     outTime,
+	}
+	if o.lrukK > 0 {
+		historyLimit := o.lrukHistoryLimit
+		if historyLimit <= 0 {
+			historyLimit = int(maxCacheLen)
+		}
+		c.history = newLRUKHistory[T, any](o.lrukK, historyLimit)
+	}
+	if o.onEvicted != nil {
+		if fn, ok := o.onEvicted.(func(T, any, EvictReason)); ok {
+			c.onEvicted = fn
+		}
+	}
+	c.jitterFraction = o.jitterFraction
+	if outTime > 0 {
+		c.gen = make(map[T]uint64, maxCacheLen)
+		c.wheel = newTimingWheel[T](o.tickDuration, o.wheelSize, c.expire)
+	}
+	return c
+}
+
+// 适用场景: 读多写少
+// - 支持过期时间，过期淘汰基于共享的哈希时间轮，O(1)挂载
+// - 单个value自动扩容
+// - 淘汰机制可插拔，默认LRU，O(1)访问/淘汰
+// - 锁机制：读写锁
+
+// AnyCache 一个可并发、防击穿、淘汰策略可插拔的缓存
+// 最小长度缓存为3，达到缓存上限时，按当前EvictionPolicy淘汰数据
+// 需通过NewAnyCache创建；零值AnyCache没有淘汰策略和底层map，Store时会panic
+type AnyCache[T comparable] struct {
+	gmutex         sync.RWMutex
+	m              map[T]any
+	policy         EvictionPolicy[T]
+	history        *lrukHistory[T, any] // 非nil时开启LRU-K准入
+	wheel          *timingWheel[T]      // 非nil时开启过期淘汰
+	gen            map[T]uint64         // 每个key当前的数据版本号，用于核实到期触发未被后续Store抢先刷新
+	onEvicted      func(T, any, EvictReason)
+	g              singleflight.Group // 防击穿
+	maxCacheLen    int
+	outTime        time.Duration
+	jitterFraction float64
+
+	hits, misses, evictions, expirations, dedups uint64
+}
+
+// Delete 根据key主动删除缓存
+func (c *AnyCache[T]) Delete(k T) {
+	c.gmutex.Lock()
+	v, ok := c.m[k]
+	delete(c.m, k)
+	delete(c.gen, k)
+	c.gmutex.Unlock()
+
+	c.policy.OnRemove(k)
+	if c.history != nil {
+		c.history.remove(k)
+	}
+	if c.wheel != nil {
+		c.wheel.Remove(k)
+	}
+	if ok && c.onEvicted != nil {
+		c.onEvicted(k, v, EvictManual)
+	}
+}
+
+// expire 由时间轮在key的TTL到期时调用。gen是该条到期挂载建立时的数据版本号；
+// 如果key在此之后又被Store刷新过（版本号已经前进），说明这次到期针对的是
+// 已经作废的旧挂载，必须放弃删除，否则会把刚刷新的新值误删掉。
+func (c *AnyCache[T]) expire(k T, gen uint64) {
+	c.gmutex.Lock()
+	if g, ok := c.gen[k]; !ok || g != gen {
+		c.gmutex.Unlock()
+		return
+	}
+	v, ok := c.m[k]
+	delete(c.m, k)
+	delete(c.gen, k)
+	c.gmutex.Unlock()
+
+	c.policy.OnRemove(k)
+	if c.history != nil {
+		c.history.remove(k)
+	}
+	if ok {
+		atomic.AddUint64(&c.expirations, 1)
+		if c.onEvicted != nil {
+			c.onEvicted(k, v, EvictExpired)
+		}
+	}
+}
+
+func (c *AnyCache[T]) Clear() {
+	c.gmutex.Lock()
+	old := c.m
+	c.m = make(map[T]any, c.maxCacheLen)
+	if c.gen != nil {
+		c.gen = make(map[T]uint64, c.maxCacheLen)
+	}
+	c.gmutex.Unlock()
+
+	for k := range old {
+		c.policy.OnRemove(k)
+	}
+	if c.history != nil {
+		c.history.clear()
+	}
+	if c.wheel != nil {
+		c.wheel.Clear()
+	}
+	if c.onEvicted != nil {
+		for k, v := range old {
+			c.onEvicted(k, v, EvictClear)
+		}
+	}
+}
+
+// Store 存储key-value数据
+func (c *AnyCache[T]) Store(key T, data any) {
+	if c.history != nil {
+		c.gmutex.RLock()
+		_, exists := c.m[key]
+		c.gmutex.RUnlock()
+		if !exists {
+			v, promote := c.history.touch(key, data)
+			if !promote {
+				return
+			}
+			data = v
+		} else {
+			c.history.remove(key)
+		}
+	}
+
+	var evictedKey T
+	var evictedVal any
+	evicted := false
+	var gen uint64
+
+	c.gmutex.Lock()
+	if _, ok := c.m[key]; !ok && len(c.m) >= c.maxCacheLen {
+		if ek, ok := c.policy.Evict(); ok {
+			if v, exists := c.m[ek]; exists {
+				evictedKey, evictedVal, evicted = ek, v, true
+				delete(c.m, ek)
+				delete(c.gen, ek)
+			}
+		}
+	}
+	c.m[key] = data
+	if c.gen != nil {
+		c.gen[key]++
+		gen = c.gen[key]
+	}
+	c.gmutex.Unlock()
+	c.policy.OnAdd(key)
+
+	if evicted {
+		atomic.AddUint64(&c.evictions, 1)
+		if c.wheel != nil {
+			c.wheel.Remove(evictedKey)
+		}
+		if c.onEvicted != nil {
+			c.onEvicted(evictedKey, evictedVal, EvictLRU)
+		}
+	}
+
+	if c.wheel != nil {
+		c.wheel.Add(key, jitterDuration(c.outTime, c.jitterFraction), gen)
+	}
+}
+
+func (c *AnyCache[T]) Load(k T) (any, bool) {
+	c.gmutex.RLock()
+	v, ok := c.m[k]
+	c.gmutex.RUnlock()
+	if ok {
+		atomic.AddUint64(&c.hits, 1)
+		c.policy.OnAccess(k)
+	} else {
+		atomic.AddUint64(&c.misses, 1)
+	}
+	return v, ok
+}
+
+// LoadOrStore 根据key读取数据，当没有数据时，根据输入的方法存储并返回数据
+func (c *AnyCache[T]) LoadOrStore(k T, fu func() (any, error)) (any, error) {
+	if v, ok := c.Load(k); ok {
+		return v, nil
+	}
+
+	res, err, shared := c.g.Do(fmt.Sprint(k), fu)
+	if shared {
+		atomic.AddUint64(&c.dedups, 1)
+	}
+	if err == nil {
+		c.Store(k, res)
+	}
+	return res, err
+}
+
+// Close 停止该AnyCache持有的过期时间轮后台goroutine。未开启TTL（outTime<=0）时是空操作。
+// 开启了TTL的AnyCache在不再使用后必须调用Close，否则时间轮的后台goroutine不会退出，造成泄漏。
+func (c *AnyCache[T]) Close() {
+	if c.wheel != nil {
+		c.wheel.Stop()
+	}
+}
+
+// Stats 返回当前命中率、淘汰等运行时指标的快照
+func (c *AnyCache[T]) Stats() Stats {
+	c.gmutex.RLock()
+	size := len(c.m)
+	c.gmutex.RUnlock()
+	return Stats{
+		Hits:        atomic.LoadUint64(&c.hits),
+		Misses:      atomic.LoadUint64(&c.misses),
+		Evictions:   atomic.LoadUint64(&c.evictions),
+		Expirations: atomic.LoadUint64(&c.expirations),
+		Dedups:      atomic.LoadUint64(&c.dedups),
+		Size:        size,
+	}
+}
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestCacheStoreRefreshSurvivesExpiryRace 针对chunk0-4的回归测试：在原TTL到期前
+// 重新Store同一个key，新值不应被原挂载触发的过期删除误删。
+func TestCacheStoreRefreshSurvivesExpiryRace(t *testing.T) {
+	const tick = 10 * time.Millisecond
+	const ttl = 100 * time.Millisecond
+
+	c := NewCache[string, string](3, ttl, WithTickDuration[string](tick), WithWheelSize[string](20))
+
+	c.Store("k", "v1")
+	time.Sleep(70 * time.Millisecond) // 原挂载(t=100)尚未到期
+	c.Store("k", "v2")
+
+	time.Sleep(60 * time.Millisecond) // 此时t=130，已过原挂载的到期点(100)，早于刷新后的到期点(170)
+
+	v, ok := c.Load("k")
+	if !ok {
+		t.Fatalf("expected k to still be present after refresh, but it was expired")
+	}
+	if v != "v2" {
+		t.Fatalf("expected refreshed value v2, got %q", v)
+	}
+}
+
+// TestCacheExpiresAfterRefreshedTTL 验证刷新后的TTL仍然正常生效：
+// 在新一轮TTL到期后，key最终应被过期淘汰。
+func TestCacheExpiresAfterRefreshedTTL(t *testing.T) {
+	const tick = 10 * time.Millisecond
+	const ttl = 100 * time.Millisecond
+
+	c := NewCache[string, string](3, ttl, WithTickDuration[string](tick), WithWheelSize[string](20))
+
+	c.Store("k", "v1")
+	time.Sleep(70 * time.Millisecond)
+	c.Store("k", "v2")
+
+	time.Sleep(130 * time.Millisecond) // 此时t=200，已过刷新后的到期点(170)
+
+	if _, ok := c.Load("k"); ok {
+		t.Fatalf("expected k to expire after its refreshed TTL elapsed")
+	}
+}
+
+// TestCacheCloseStopsWheelGoroutine 验证Close会停止TTL Cache持有的时间轮后台
+// goroutine，而不是让它在Cache不再被引用后继续运行。
+func TestCacheCloseStopsWheelGoroutine(t *testing.T) {
+	runtime.GC()
+	before := runtime.NumGoroutine()
+
+	const n = 50
+	caches := make([]*Cache[int, int], n)
+	for i := range caches {
+		caches[i] = NewCache[int, int](3, 50*time.Millisecond)
+	}
+
+	afterOpen := runtime.NumGoroutine()
+	if afterOpen < before+n {
+		t.Fatalf("expected at least %d new goroutines after opening %d TTL caches, before=%d after=%d", n, n, before, afterOpen)
+	}
+
+	for _, c := range caches {
+		c.Close()
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		runtime.GC()
+		if runtime.NumGoroutine() <= before {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if got := runtime.NumGoroutine(); got > before {
+		t.Fatalf("expected wheel goroutines to exit after Close, before=%d after=%d", before, got)
+	}
+}
@@ -0,0 +1,87 @@
+package cache
+
+import "testing"
+
+// TestFIFOPolicyEvictsInsertionOrder 验证FIFO只按插入顺序淘汰，
+// 访问(OnAccess)不会改变淘汰顺序。
+func TestFIFOPolicyEvictsInsertionOrder(t *testing.T) {
+	p := NewFIFOPolicy[string]()
+
+	p.OnAdd("a")
+	p.OnAdd("b")
+	p.OnAdd("c")
+	p.OnAccess("a") // FIFO下访问不影响淘汰顺序
+
+	k, ok := p.Evict()
+	if !ok || k != "a" {
+		t.Fatalf("expected a (first inserted) to be evicted first, got %v, %v", k, ok)
+	}
+	k, ok = p.Evict()
+	if !ok || k != "b" {
+		t.Fatalf("expected b to be evicted next, got %v, %v", k, ok)
+	}
+	k, ok = p.Evict()
+	if !ok || k != "c" {
+		t.Fatalf("expected c to be evicted last, got %v, %v", k, ok)
+	}
+	if _, ok := p.Evict(); ok {
+		t.Fatalf("expected no more keys to evict")
+	}
+}
+
+// TestFIFOPolicyOnAddIgnoresExistingKey 验证重复OnAdd同一个key不会重置其插入位置。
+func TestFIFOPolicyOnAddIgnoresExistingKey(t *testing.T) {
+	p := NewFIFOPolicy[string]()
+
+	p.OnAdd("a")
+	p.OnAdd("b")
+	p.OnAdd("a") // 重复写入不应把a移到队尾
+
+	k, ok := p.Evict()
+	if !ok || k != "a" {
+		t.Fatalf("expected a to still be evicted first despite the repeat OnAdd, got %v, %v", k, ok)
+	}
+}
+
+// TestLFUPolicyEvictsLeastFrequentlyUsed 验证Evict优先淘汰访问次数最少的key。
+func TestLFUPolicyEvictsLeastFrequentlyUsed(t *testing.T) {
+	p := NewLFUPolicy[string]()
+
+	p.OnAdd("a")
+	p.OnAdd("b")
+	p.OnAdd("c")
+	p.OnAccess("a")
+	p.OnAccess("a")
+	p.OnAccess("b")
+
+	k, ok := p.Evict()
+	if !ok || k != "c" {
+		t.Fatalf("expected c (count=1, least frequent) to be evicted first, got %v, %v", k, ok)
+	}
+	k, ok = p.Evict()
+	if !ok || k != "b" {
+		t.Fatalf("expected b (count=2) to be evicted next, got %v, %v", k, ok)
+	}
+	k, ok = p.Evict()
+	if !ok || k != "a" {
+		t.Fatalf("expected a (count=3, most frequent) to be evicted last, got %v, %v", k, ok)
+	}
+}
+
+// TestLFUPolicyOnRemoveDropsKeyFromHeap 验证OnRemove把key从堆中摘除后，
+// 该key不会再被Evict选中。
+func TestLFUPolicyOnRemoveDropsKeyFromHeap(t *testing.T) {
+	p := NewLFUPolicy[string]()
+
+	p.OnAdd("a")
+	p.OnAdd("b")
+	p.OnRemove("a")
+
+	k, ok := p.Evict()
+	if !ok || k != "b" {
+		t.Fatalf("expected b to be the only remaining eviction candidate, got %v, %v", k, ok)
+	}
+	if _, ok := p.Evict(); ok {
+		t.Fatalf("expected a to have been removed from the heap")
+	}
+}
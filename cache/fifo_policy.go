@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// fifoPolicy 先进先出淘汰策略，只按插入顺序淘汰，命中不影响淘汰顺序
+type fifoPolicy[K comparable] struct {
+	mu    sync.Mutex
+	ll    *list.List
+	items map[K]*list.Element
+}
+
+// NewFIFOPolicy 生成先进先出淘汰策略
+func NewFIFOPolicy[K comparable]() EvictionPolicy[K] {
+	return &fifoPolicy[K]{
+		ll:    list.New(),
+		items: make(map[K]*list.Element),
+	}
+}
+
+// OnAccess FIFO不关心访问顺序
+func (p *fifoPolicy[K]) OnAccess(_ K) {}
+
+func (p *fifoPolicy[K]) OnAdd(k K) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.items[k]; ok {
+		return
+	}
+	p.items[k] = p.ll.PushBack(k)
+}
+
+func (p *fifoPolicy[K]) OnRemove(k K) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e, ok := p.items[k]; ok {
+		p.ll.Remove(e)
+		delete(p.items, k)
+	}
+}
+
+func (p *fifoPolicy[K]) Evict() (K, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	e := p.ll.Front()
+	if e == nil {
+		var zero K
+		return zero, false
+	}
+	k := e.Value.(K)
+	p.ll.Remove(e)
+	delete(p.items, k)
+	return k, true
+}
@@ -0,0 +1,19 @@
+package cache
+
+import (
+	"math/rand"
+	"time"
+)
+
+// jitterDuration 把base按[1-fraction, 1+fraction]的比例随机扰动，
+// 用于打散一批TTL相同的key的到期时间，避免它们在同一时刻集中过期引发击穿
+func jitterDuration(base time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 || base <= 0 {
+		return base
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	delta := (rand.Float64()*2 - 1) * fraction
+	return time.Duration(float64(base) * (1 + delta))
+}
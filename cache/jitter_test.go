@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+// TestJitterDurationStaysWithinFractionBounds 验证jitterDuration的结果
+// 始终落在[1-fraction, 1+fraction]*base的范围内。
+func TestJitterDurationStaysWithinFractionBounds(t *testing.T) {
+	const base = 100 * time.Millisecond
+	const fraction = 0.2
+
+	min := time.Duration(float64(base) * (1 - fraction))
+	max := time.Duration(float64(base) * (1 + fraction))
+
+	for i := 0; i < 1000; i++ {
+		got := jitterDuration(base, fraction)
+		if got < min || got > max {
+			t.Fatalf("jitterDuration(%v, %v) = %v, want within [%v, %v]", base, fraction, got, min, max)
+		}
+	}
+}
+
+// TestJitterDurationNoopWhenFractionOrBaseNonPositive 验证fraction<=0或base<=0时
+// 直接返回原值，不做任何扰动。
+func TestJitterDurationNoopWhenFractionOrBaseNonPositive(t *testing.T) {
+	const base = 100 * time.Millisecond
+
+	if got := jitterDuration(base, 0); got != base {
+		t.Fatalf("expected no jitter when fraction=0, got %v", got)
+	}
+	if got := jitterDuration(base, -0.1); got != base {
+		t.Fatalf("expected no jitter when fraction<0, got %v", got)
+	}
+	if got := jitterDuration(0, 0.2); got != 0 {
+		t.Fatalf("expected no jitter when base=0, got %v", got)
+	}
+}
+
+// TestJitterDurationClampsFractionAboveOne 验证fraction>1时被钳制到1，
+// 结果不会是负数或超过2倍base。
+func TestJitterDurationClampsFractionAboveOne(t *testing.T) {
+	const base = 100 * time.Millisecond
+
+	for i := 0; i < 1000; i++ {
+		got := jitterDuration(base, 1.5)
+		if got < 0 || got > 2*base {
+			t.Fatalf("jitterDuration(%v, 1.5) = %v, want within [0, %v]", base, got, 2*base)
+		}
+	}
+}
+
+// TestCacheWithExpiryJitterProducesVaryingExpiry 验证WithExpiryJitter
+// 确实会让同一TTL下不同key的实际到期挂载时间出现差异，而不是全部相同。
+func TestCacheWithExpiryJitterProducesVaryingExpiry(t *testing.T) {
+	c := NewCache[string, string](8, 100*time.Millisecond, WithExpiryJitter[string](0.5))
+	defer c.Close()
+
+	for i := 0; i < 5; i++ {
+		c.Store(string(rune('a'+i)), "v")
+	}
+
+	seen := make(map[time.Time]bool)
+	c.wheel.mu.Lock()
+	for _, loc := range c.wheel.items {
+		seen[loc.elem.Value.(*wheelEntry[string]).expiresAt] = true
+	}
+	c.wheel.mu.Unlock()
+
+	if len(seen) < 2 {
+		t.Fatalf("expected jitter to spread out expiry times across keys, got %d distinct values", len(seen))
+	}
+}
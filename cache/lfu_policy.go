@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// lfuEntry 堆节点，记录key当前的访问次数及其在堆中的位置
+type lfuEntry[K comparable] struct {
+	key   K
+	count int
+	index int
+}
+
+// lfuHeap 按访问次数排序的最小堆
+type lfuHeap[K comparable] []*lfuEntry[K]
+
+func (h lfuHeap[K]) Len() int           { return len(h) }
+func (h lfuHeap[K]) Less(i, j int) bool { return h[i].count < h[j].count }
+func (h lfuHeap[K]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *lfuHeap[K]) Push(x any) {
+	e := x.(*lfuEntry[K])
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *lfuHeap[K]) Pop() any {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return e
+}
+
+// lfuPolicy 基于访问次数最小堆实现的LFU淘汰策略，优先淘汰命中次数最少的key
+type lfuPolicy[K comparable] struct {
+	mu      sync.Mutex
+	h       lfuHeap[K]
+	entries map[K]*lfuEntry[K]
+}
+
+// NewLFUPolicy 生成最不经常使用淘汰策略
+func NewLFUPolicy[K comparable]() EvictionPolicy[K] {
+	return &lfuPolicy[K]{entries: make(map[K]*lfuEntry[K])}
+}
+
+func (p *lfuPolicy[K]) OnAccess(k K) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e, ok := p.entries[k]; ok {
+		e.count++
+		heap.Fix(&p.h, e.index)
+	}
+}
+
+func (p *lfuPolicy[K]) OnAdd(k K) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e, ok := p.entries[k]; ok {
+		e.count++
+		heap.Fix(&p.h, e.index)
+		return
+	}
+	e := &lfuEntry[K]{key: k, count: 1}
+	p.entries[k] = e
+	heap.Push(&p.h, e)
+}
+
+func (p *lfuPolicy[K]) OnRemove(k K) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e, ok := p.entries[k]; ok {
+		heap.Remove(&p.h, e.index)
+		delete(p.entries, k)
+	}
+}
+
+func (p *lfuPolicy[K]) Evict() (K, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.h.Len() == 0 {
+		var zero K
+		return zero, false
+	}
+	e := heap.Pop(&p.h).(*lfuEntry[K])
+	delete(p.entries, e.key)
+	return e.key, true
+}
@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lruPolicy 基于双向链表+哈希表实现的LRU淘汰策略，访问/新增/淘汰均为O(1)
+type lruPolicy[K comparable] struct {
+	mu    sync.Mutex
+	ll    *list.List
+	items map[K]*list.Element
+}
+
+// NewLRUPolicy 生成最近最少使用淘汰策略，是Cache/AnyCache的默认策略
+func NewLRUPolicy[K comparable]() EvictionPolicy[K] {
+	return &lruPolicy[K]{
+		ll:    list.New(),
+		items: make(map[K]*list.Element),
+	}
+}
+
+func (p *lruPolicy[K]) OnAccess(k K) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e, ok := p.items[k]; ok {
+		p.ll.MoveToFront(e)
+	}
+}
+
+func (p *lruPolicy[K]) OnAdd(k K) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e, ok := p.items[k]; ok {
+		p.ll.MoveToFront(e)
+		return
+	}
+	p.items[k] = p.ll.PushFront(k)
+}
+
+func (p *lruPolicy[K]) OnRemove(k K) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e, ok := p.items[k]; ok {
+		p.ll.Remove(e)
+		delete(p.items, k)
+	}
+}
+
+func (p *lruPolicy[K]) Evict() (K, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	e := p.ll.Back()
+	if e == nil {
+		var zero K
+		return zero, false
+	}
+	k := e.Value.(K)
+	p.ll.Remove(e)
+	delete(p.items, k)
+	return k, true
+}
@@ -0,0 +1,51 @@
+package cache
+
+import "testing"
+
+// TestLRUPolicyEvictsLeastRecentlyUsed 验证Evict按最近最少使用顺序淘汰，
+// 且OnAccess/OnAdd都会把key移到链表前端（最近使用一侧）。
+func TestLRUPolicyEvictsLeastRecentlyUsed(t *testing.T) {
+	p := NewLRUPolicy[string]()
+
+	p.OnAdd("a")
+	p.OnAdd("b")
+	p.OnAdd("c")
+	p.OnAccess("a") // a被重新访问，应从最旧移到最新
+
+	k, ok := p.Evict()
+	if !ok || k != "b" {
+		t.Fatalf("expected b (now least recently used) to be evicted, got %v, %v", k, ok)
+	}
+
+	k, ok = p.Evict()
+	if !ok || k != "c" {
+		t.Fatalf("expected c to be evicted next, got %v, %v", k, ok)
+	}
+
+	k, ok = p.Evict()
+	if !ok || k != "a" {
+		t.Fatalf("expected a to be evicted last, got %v, %v", k, ok)
+	}
+
+	if _, ok := p.Evict(); ok {
+		t.Fatalf("expected no more keys to evict")
+	}
+}
+
+// TestLRUPolicyOnRemoveDropsKeyFromEvictionOrder 验证OnRemove将key从链表中摘除后，
+// 该key不会再被Evict选中。
+func TestLRUPolicyOnRemoveDropsKeyFromEvictionOrder(t *testing.T) {
+	p := NewLRUPolicy[string]()
+
+	p.OnAdd("a")
+	p.OnAdd("b")
+	p.OnRemove("a")
+
+	k, ok := p.Evict()
+	if !ok || k != "b" {
+		t.Fatalf("expected b to be the only remaining eviction candidate, got %v, %v", k, ok)
+	}
+	if _, ok := p.Evict(); ok {
+		t.Fatalf("expected a to have been removed from eviction order")
+	}
+}
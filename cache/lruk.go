@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lrukHistoryEntry 记录尚未进入主缓存的key的历史访问信息
+type lrukHistoryEntry[K comparable, V any] struct {
+	key   K
+	value V
+	count int
+}
+
+// lrukHistory LRU-K的历史访问队列：只有访问次数达到k才允许晋升进主缓存，
+// 避免一次性的扫描式读写把真正的热点数据挤出LRU。历史队列本身按FIFO淘汰。
+type lrukHistory[K comparable, V any] struct {
+	mu           sync.Mutex
+	k            int
+	historyLimit int
+	ll           *list.List
+	items        map[K]*list.Element
+}
+
+func newLRUKHistory[K comparable, V any](k, historyLimit int) *lrukHistory[K, V] {
+	if k < 1 {
+		k = 1
+	}
+	if historyLimit < 1 {
+		historyLimit = 1
+	}
+	return &lrukHistory[K, V]{
+		k:            k,
+		historyLimit: historyLimit,
+		ll:           list.New(),
+		items:        make(map[K]*list.Element),
+	}
+}
+
+// touch 记录一次访问，当累计访问次数达到k时返回(value, true)，表示应当晋升进主缓存
+func (h *lrukHistory[K, V]) touch(key K, value V) (V, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if e, ok := h.items[key]; ok {
+		entry := e.Value.(*lrukHistoryEntry[K, V])
+		entry.value = value
+		entry.count++
+		if entry.count >= h.k {
+			h.ll.Remove(e)
+			delete(h.items, key)
+			return entry.value, true
+		}
+		return entry.value, false
+	}
+
+	if h.ll.Len() >= h.historyLimit {
+		if back := h.ll.Back(); back != nil {
+			delete(h.items, back.Value.(*lrukHistoryEntry[K, V]).key)
+			h.ll.Remove(back)
+		}
+	}
+	entry := &lrukHistoryEntry[K, V]{key: key, value: value, count: 1}
+	if entry.count >= h.k {
+		return entry.value, true
+	}
+	h.items[key] = h.ll.PushFront(entry)
+	return entry.value, false
+}
+
+func (h *lrukHistory[K, V]) remove(key K) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if e, ok := h.items[key]; ok {
+		h.ll.Remove(e)
+		delete(h.items, key)
+	}
+}
+
+func (h *lrukHistory[K, V]) clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.ll.Init()
+	h.items = make(map[K]*list.Element)
+}
@@ -0,0 +1,81 @@
+package cache
+
+import "testing"
+
+// TestLRUKHistoryPromotesOnKthTouch 验证touch在累计访问次数达到k之前返回(_, false)，
+// 只有第k次才返回(_, true)表示应当晋升进主缓存。
+func TestLRUKHistoryPromotesOnKthTouch(t *testing.T) {
+	h := newLRUKHistory[string, string](3, 10)
+
+	if _, promote := h.touch("a", "v1"); promote {
+		t.Fatalf("expected 1st touch not to promote")
+	}
+	if _, promote := h.touch("a", "v2"); promote {
+		t.Fatalf("expected 2nd touch not to promote")
+	}
+	v, promote := h.touch("a", "v3")
+	if !promote {
+		t.Fatalf("expected 3rd touch (k=3) to promote")
+	}
+	if v != "v3" {
+		t.Fatalf("expected promoted value to be the latest touch's value, got %q", v)
+	}
+}
+
+// TestLRUKHistoryRemoveResetsCount 验证remove把key从历史队列中摘除后，
+// 再次touch视为全新的第一次访问，而不是延续之前的计数。
+func TestLRUKHistoryRemoveResetsCount(t *testing.T) {
+	h := newLRUKHistory[string, string](2, 10)
+
+	h.touch("a", "v1")
+	h.remove("a")
+
+	if _, promote := h.touch("a", "v2"); promote {
+		t.Fatalf("expected touch count to restart from 1 after remove, got promote=true")
+	}
+}
+
+// TestLRUKHistoryEvictsOldestOnOverflow 验证历史队列达到historyLimit后，
+// 会按FIFO顺序淘汰最早进入历史队列的key。
+func TestLRUKHistoryEvictsOldestOnOverflow(t *testing.T) {
+	h := newLRUKHistory[string, string](5, 2)
+
+	h.touch("a", "v1")
+	h.touch("b", "v1")
+	h.touch("c", "v1") // 历史队列容量为2，a应被挤出
+
+	if _, ok := h.items["a"]; ok {
+		t.Fatalf("expected a to have been evicted from the history queue once it overflowed")
+	}
+	if _, ok := h.items["b"]; !ok {
+		t.Fatalf("expected b to remain in the history queue")
+	}
+	if _, ok := h.items["c"]; !ok {
+		t.Fatalf("expected c to remain in the history queue")
+	}
+}
+
+// TestCacheWithLRUKAdmitsOnlyAfterKStores 验证开启WithLRUK后，
+// Store未满k次访问的key不会进入主缓存，直到第k次才真正写入并可被Load读到。
+func TestCacheWithLRUKAdmitsOnlyAfterKStores(t *testing.T) {
+	c := NewCache[string, string](3, 0, WithLRUK[string](3, 10))
+
+	c.Store("a", "v1")
+	if _, ok := c.Load("a"); ok {
+		t.Fatalf("expected a not to be admitted into the main cache before the 3rd store")
+	}
+
+	c.Store("a", "v2")
+	if _, ok := c.Load("a"); ok {
+		t.Fatalf("expected a still not admitted after only 2 stores")
+	}
+
+	c.Store("a", "v3")
+	v, ok := c.Load("a")
+	if !ok {
+		t.Fatalf("expected a to be admitted into the main cache on the 3rd store")
+	}
+	if v != "v3" {
+		t.Fatalf("expected admitted value to be the 3rd store's value, got %q", v)
+	}
+}
@@ -0,0 +1,76 @@
+package cache
+
+import "time"
+
+// Option 用于配置Cache/AnyCache的可选参数
+type Option[T1 comparable] func(*cacheOptions[T1])
+
+type cacheOptions[T1 comparable] struct {
+	policy           EvictionPolicy[T1]
+	lrukK            int
+	lrukHistoryLimit int
+	tickDuration     time.Duration
+	wheelSize        int
+	onEvicted        any // func(T1, T2, EvictReason)，T2在NewCache/NewAnyCache中才能确定，这里先以any暂存
+	jitterFraction   float64
+}
+
+// WithPolicy 指定缓存淘汰策略，不指定时默认使用LRU
+func WithPolicy[T1 comparable](policy EvictionPolicy[T1]) Option[T1] {
+	return func(o *cacheOptions[T1]) {
+		o.policy = policy
+	}
+}
+
+// WithLRUK 开启LRU-K准入机制：key需被Store/LoadOrStore访问满k次才会进入主缓存，
+// 在此之前的访问记录保存在一个最多historyLimit条的FIFO历史队列中。
+// 用于避免一次性的大批量扫描式写入把真正的热点数据挤出LRU。
+func WithLRUK[T1 comparable](k int, historyLimit int) Option[T1] {
+	return func(o *cacheOptions[T1]) {
+		o.lrukK = k
+		o.lrukHistoryLimit = historyLimit
+	}
+}
+
+// WithTickDuration 设置过期时间轮单格的时长，不设置时默认1秒
+func WithTickDuration[T1 comparable](tick time.Duration) Option[T1] {
+	return func(o *cacheOptions[T1]) {
+		o.tickDuration = tick
+	}
+}
+
+// WithWheelSize 设置过期时间轮的格数，不设置时默认60格
+func WithWheelSize[T1 comparable](size int) Option[T1] {
+	return func(o *cacheOptions[T1]) {
+		o.wheelSize = size
+	}
+}
+
+// WithOnEvicted 注册淘汰回调，在key因容量淘汰、过期、手动删除或整体清空而移除时触发，
+// reason标明触发原因(EvictLRU/EvictExpired/EvictManual/EvictClear)。
+// 回调总是在Cache的内部锁之外执行，可以安全地在回调里重新读写同一个Cache。
+func WithOnEvicted[T1 comparable, T2 any](fn func(T1, T2, EvictReason)) Option[T1] {
+	return func(o *cacheOptions[T1]) {
+		o.onEvicted = fn
+	}
+}
+
+// WithExpiryJitter 给每次Store生效的过期时间叠加一个[1-fraction, 1+fraction]的随机扰动，
+// 例如fraction=0.05即±5%。用于打散同一批次、同一TTL的key的过期时间，
+// 避免LoadOrStore场景下大量key在同一时刻集中过期引发的缓存雪崩。
+func WithExpiryJitter[T1 comparable](fraction float64) Option[T1] {
+	return func(o *cacheOptions[T1]) {
+		o.jitterFraction = fraction
+	}
+}
+
+func newCacheOptions[T1 comparable](opts ...Option[T1]) *cacheOptions[T1] {
+	o := &cacheOptions[T1]{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.policy == nil {
+		o.policy = NewLRUPolicy[T1]()
+	}
+	return o
+}
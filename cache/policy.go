@@ -0,0 +1,15 @@
+package cache
+
+// EvictionPolicy 缓存淘汰策略，负责决定Store/Load发生时如何维护内部顺序/频次，
+// 以及在缓存已满时选出应当被淘汰的key。
+// 实现需要自行保证并发安全。
+type EvictionPolicy[K comparable] interface {
+	// OnAccess 在key被Load命中时调用
+	OnAccess(k K)
+	// OnAdd 在key被Store写入（新增或覆盖）时调用
+	OnAdd(k K)
+	// OnRemove 在key被主动删除（Delete/Clear/过期）时调用，用于同步清理策略内部状态
+	OnRemove(k K)
+	// Evict 在需要腾出空间时调用，返回应当被淘汰的key；策略为空时返回(zero, false)
+	Evict() (K, bool)
+}
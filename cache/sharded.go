@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// Hasher 将任意可比较的key映射为uint64，用于分片路由
+type Hasher[T comparable] func(key T) uint64
+
+// defaultHasher string走fnv直接哈希，其他类型退化为fmt.Sprint后再哈希
+func defaultHasher[T comparable]() Hasher[T] {
+	return func(key T) uint64 {
+		s, ok := any(key).(string)
+		if !ok {
+			s = fmt.Sprint(key)
+		}
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(s))
+		return h.Sum64()
+	}
+}
+
+// ShardedOption 用于配置ShardedCache/ShardedAnyCache的可选参数
+type ShardedOption[T1 comparable] func(*shardedOptions[T1])
+
+type shardedOptions[T1 comparable] struct {
+	shards        int
+	hasher        Hasher[T1]
+	cacheOpts     []Option[T1]
+	policyFactory func() EvictionPolicy[T1]
+}
+
+// WithShards 指定分片数量，非2的幂次时向上取整到最近的2的幂次，默认16
+func WithShards[T1 comparable](n int) ShardedOption[T1] {
+	return func(o *shardedOptions[T1]) {
+		o.shards = n
+	}
+}
+
+// WithHasher 指定key到分片的哈希函数，默认string类型走fnv，其他类型走fmt.Sprint+fnv
+func WithHasher[T1 comparable](h Hasher[T1]) ShardedOption[T1] {
+	return func(o *shardedOptions[T1]) {
+		o.hasher = h
+	}
+}
+
+// WithShardOptions 将Option[T1]（WithLRUK/WithTickDuration/WithWheelSize/
+// WithOnEvicted/WithExpiryJitter等）原样转发给每个分片的NewCache/NewAnyCache调用，
+// 否则分片内部一律是默认LRU、无TTL调优、无回调、无抖动，上述选项形同虚设。
+// 不要在这里传入WithPolicy：同一个Option值会被用于构造每一个分片，WithPolicy携带的
+// 是单个EvictionPolicy实例，结果是所有分片共享同一份淘汰策略状态。需要自定义淘汰策略时
+// 改用WithPolicyFactory，NewShardedCache/NewShardedAnyCache会在检测到WithPolicy时panic。
+func WithShardOptions[T1 comparable](opts ...Option[T1]) ShardedOption[T1] {
+	return func(o *shardedOptions[T1]) {
+		o.cacheOpts = append(o.cacheOpts, opts...)
+	}
+}
+
+// WithPolicyFactory 为每个分片独立调用一次factory，各自生成一个EvictionPolicy实例，
+// 用于在ShardedCache/ShardedAnyCache上启用LFU/FIFO/ARC/自定义淘汰策略而不让分片间
+// 共享同一份策略状态。不设置时每个分片各自使用默认的LRU策略（同样互不共享）。
+func WithPolicyFactory[T1 comparable](factory func() EvictionPolicy[T1]) ShardedOption[T1] {
+	return func(o *shardedOptions[T1]) {
+		o.policyFactory = factory
+	}
+}
+
+func newShardedOptions[T1 comparable](opts ...ShardedOption[T1]) *shardedOptions[T1] {
+	o := &shardedOptions[T1]{shards: 16}
+	for _, opt := range opts {
+		opt(o)
+	}
+	o.shards = nextPowerOfTwo(o.shards)
+	if o.hasher == nil {
+		o.hasher = defaultHasher[T1]()
+	}
+	return o
+}
+
+func nextPowerOfTwo(n int) int {
+	if n < 1 {
+		n = 1
+	}
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// ShardedCache 由N个独立的Cache分片组成，每个分片拥有自己的锁、LRU状态和时间轮，
+// 通过哈希将key路由到固定分片，消除单一全局锁在多核读写下的瓶颈
+type ShardedCache[T1, T2 comparable] struct {
+	shards []*Cache[T1, T2]
+	mask   uint64
+	hasher Hasher[T1]
+}
+
+// NewShardedCache 创建分片缓存，maxCacheLen/outTime分别应用于每个分片
+func NewShardedCache[T1, T2 comparable](maxCacheLen uint16, outTime time.Duration, opts ...ShardedOption[T1]) *ShardedCache[T1, T2] {
+	o := newShardedOptions(opts...)
+	sc := &ShardedCache[T1, T2]{
+		shards: make([]*Cache[T1, T2], o.shards),
+		mask:   uint64(o.shards - 1),
+		hasher: o.hasher,
+	}
+	for i := range sc.shards {
+		sc.shards[i] = NewCache[T1, T2](maxCacheLen, outTime, shardCacheOpts(o)...)
+	}
+	return sc
+}
+
+// shardCacheOpts 为第i个分片组装NewCache/NewAnyCache的Option列表：拒绝通过
+// WithShardOptions混入的WithPolicy（会被所有分片共享同一个实例），并在设置了
+// WithPolicyFactory时为这个分片单独生成一份EvictionPolicy。
+func shardCacheOpts[T1 comparable](o *shardedOptions[T1]) []Option[T1] {
+	probe := &cacheOptions[T1]{}
+	for _, opt := range o.cacheOpts {
+		opt(probe)
+	}
+	if probe.policy != nil {
+		panic("cache: WithPolicy passed via WithShardOptions would be shared by every shard; use WithPolicyFactory instead")
+	}
+	if o.policyFactory == nil {
+		return o.cacheOpts
+	}
+	return append(append([]Option[T1]{}, o.cacheOpts...), WithPolicy(o.policyFactory()))
+}
+
+func (sc *ShardedCache[T1, T2]) shardFor(k T1) *Cache[T1, T2] {
+	return sc.shards[sc.hasher(k)&sc.mask]
+}
+
+// Store 存储key-value数据
+func (sc *ShardedCache[T1, T2]) Store(key T1, data T2) {
+	sc.shardFor(key).Store(key, data)
+}
+
+func (sc *ShardedCache[T1, T2]) Load(k T1) (T2, bool) {
+	return sc.shardFor(k).Load(k)
+}
+
+// Delete 根据key主动删除缓存
+func (sc *ShardedCache[T1, T2]) Delete(k T1) {
+	sc.shardFor(k).Delete(k)
+}
+
+func (sc *ShardedCache[T1, T2]) Clear() {
+	for _, s := range sc.shards {
+		s.Clear()
+	}
+}
+
+// Close 停止所有分片持有的过期时间轮后台goroutine，开启了TTL的ShardedCache
+// 在不再使用后必须调用Close，否则每个分片的时间轮goroutine都不会退出，造成泄漏。
+func (sc *ShardedCache[T1, T2]) Close() {
+	for _, s := range sc.shards {
+		s.Close()
+	}
+}
+
+// LoadOrStore 根据key读取数据，当没有数据时，根据输入的方法存储并返回数据
+func (sc *ShardedCache[T1, T2]) LoadOrStore(k T1, fu func() (T2, error)) (T2, error) {
+	return sc.shardFor(k).LoadOrStore(k, fu)
+}
+
+// Stats 汇总所有分片的运行时指标
+func (sc *ShardedCache[T1, T2]) Stats() Stats {
+	var s Stats
+	for _, shard := range sc.shards {
+		ss := shard.Stats()
+		s.Hits += ss.Hits
+		s.Misses += ss.Misses
+		s.Evictions += ss.Evictions
+		s.Expirations += ss.Expirations
+		s.Dedups += ss.Dedups
+		s.Size += ss.Size
+	}
+	return s
+}
@@ -0,0 +1,76 @@
+package cache
+
+import "time"
+
+// ShardedAnyCache 由N个独立的AnyCache分片组成，语义与ShardedCache一致，value类型为any
+type ShardedAnyCache[T comparable] struct {
+	shards []*AnyCache[T]
+	mask   uint64
+	hasher Hasher[T]
+}
+
+// NewShardedAnyCache 创建分片缓存，maxCacheLen/outTime分别应用于每个分片
+func NewShardedAnyCache[T comparable](maxCacheLen uint16, outTime time.Duration, opts ...ShardedOption[T]) *ShardedAnyCache[T] {
+	o := newShardedOptions(opts...)
+	sc := &ShardedAnyCache[T]{
+		shards: make([]*AnyCache[T], o.shards),
+		mask:   uint64(o.shards - 1),
+		hasher: o.hasher,
+	}
+	for i := range sc.shards {
+		sc.shards[i] = NewAnyCache[T](maxCacheLen, outTime, shardCacheOpts(o)...)
+	}
+	return sc
+}
+
+func (sc *ShardedAnyCache[T]) shardFor(k T) *AnyCache[T] {
+	return sc.shards[sc.hasher(k)&sc.mask]
+}
+
+// Store 存储key-value数据
+func (sc *ShardedAnyCache[T]) Store(key T, data any) {
+	sc.shardFor(key).Store(key, data)
+}
+
+func (sc *ShardedAnyCache[T]) Load(k T) (any, bool) {
+	return sc.shardFor(k).Load(k)
+}
+
+// Delete 根据key主动删除缓存
+func (sc *ShardedAnyCache[T]) Delete(k T) {
+	sc.shardFor(k).Delete(k)
+}
+
+func (sc *ShardedAnyCache[T]) Clear() {
+	for _, s := range sc.shards {
+		s.Clear()
+	}
+}
+
+// Close 停止所有分片持有的过期时间轮后台goroutine，开启了TTL的ShardedAnyCache
+// 在不再使用后必须调用Close，否则每个分片的时间轮goroutine都不会退出，造成泄漏。
+func (sc *ShardedAnyCache[T]) Close() {
+	for _, s := range sc.shards {
+		s.Close()
+	}
+}
+
+// LoadOrStore 根据key读取数据，当没有数据时，根据输入的方法存储并返回数据
+func (sc *ShardedAnyCache[T]) LoadOrStore(k T, fu func() (any, error)) (any, error) {
+	return sc.shardFor(k).LoadOrStore(k, fu)
+}
+
+// Stats 汇总所有分片的运行时指标
+func (sc *ShardedAnyCache[T]) Stats() Stats {
+	var s Stats
+	for _, shard := range sc.shards {
+		ss := shard.Stats()
+		s.Hits += ss.Hits
+		s.Misses += ss.Misses
+		s.Evictions += ss.Evictions
+		s.Expirations += ss.Expirations
+		s.Dedups += ss.Dedups
+		s.Size += ss.Size
+	}
+	return s
+}
@@ -0,0 +1,85 @@
+package cache
+
+import "testing"
+
+// TestShardedCacheRoutesByHash 验证同一个key总是路由到同一个分片，
+// 且Store/Load跨分片均可正确命中。
+func TestShardedCacheRoutesByHash(t *testing.T) {
+	sc := NewShardedCache[string, int](64, 0, WithShards[string](4))
+
+	for i := 0; i < 20; i++ {
+		k := string(rune('a' + i))
+		sc.Store(k, i)
+	}
+	for i := 0; i < 20; i++ {
+		k := string(rune('a' + i))
+		v, ok := sc.Load(k)
+		if !ok || v != i {
+			t.Fatalf("expected key %q to load %d, got %d, %v", k, i, v, ok)
+		}
+		if got, want := sc.shardFor(k), sc.shards[sc.hasher(k)&sc.mask]; got != want {
+			t.Fatalf("expected key %q to route to a stable shard", k)
+		}
+	}
+}
+
+// TestShardedCacheStatsAggregatesShards 验证Stats()是各分片指标的简单求和。
+func TestShardedCacheStatsAggregatesShards(t *testing.T) {
+	sc := NewShardedCache[string, int](3, 0, WithShards[string](4))
+
+	sc.Store("a", 1)
+	sc.Store("b", 2)
+	sc.Load("a")
+	sc.Load("missing")
+
+	var want Stats
+	for _, s := range sc.shards {
+		ss := s.Stats()
+		want.Hits += ss.Hits
+		want.Misses += ss.Misses
+		want.Size += ss.Size
+	}
+
+	got := sc.Stats()
+	if got.Hits != want.Hits || got.Misses != want.Misses || got.Size != want.Size {
+		t.Fatalf("expected aggregated stats %+v, got %+v", want, got)
+	}
+	if got.Size != 2 {
+		t.Fatalf("expected total size 2 across shards, got %d", got.Size)
+	}
+}
+
+// TestShardedCacheWithShardOptionsRejectsSharedPolicy 验证通过WithShardOptions
+// 传入WithPolicy会panic，因为该实例会被所有分片共享。
+func TestShardedCacheWithShardOptionsRejectsSharedPolicy(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected NewShardedCache to panic on a shared WithPolicy instance")
+		}
+	}()
+	NewShardedCache[string, int](3, 0, WithShardOptions[string](WithPolicy[string](NewLRUPolicy[string]())))
+}
+
+// TestShardedCacheWithPolicyFactoryBuildsDistinctInstances 验证WithPolicyFactory
+// 为每个分片各自调用一次，得到互不共享的EvictionPolicy实例。
+func TestShardedCacheWithPolicyFactoryBuildsDistinctInstances(t *testing.T) {
+	calls := 0
+	sc := NewShardedCache[string, int](3, 0, WithShards[string](4), WithPolicyFactory[string](func() EvictionPolicy[string] {
+		calls++
+		return NewLRUPolicy[string]()
+	}))
+
+	if calls != 4 {
+		t.Fatalf("expected policy factory to be called once per shard (4), got %d", calls)
+	}
+	for i, s := range sc.shards {
+		for j, other := range sc.shards {
+			if i == j {
+				continue
+			}
+			if s.policy == other.policy {
+				t.Fatalf("expected distinct policy instances per shard, shard %d and %d share one", i, j)
+			}
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package cache
+
+// EvictReason 描述一次淘汰回调的触发原因
+type EvictReason int
+
+const (
+	// EvictLRU key因达到容量上限被当前EvictionPolicy选中淘汰
+	EvictLRU EvictReason = iota
+	// EvictExpired key因TTL到期被时间轮淘汰
+	EvictExpired
+	// EvictManual key被Delete主动删除
+	EvictManual
+	// EvictClear key被Clear整体清空
+	EvictClear
+)
+
+// Stats 缓存运行时指标快照，计数器基于sync/atomic维护，读取不与数据锁竞争
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+	Dedups      uint64 // LoadOrStore的结果经singleflight与其他并发调用共享的次数，执行loader的那次调用与加入等待的调用都计入
+	Size        int
+}
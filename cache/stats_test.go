@@ -0,0 +1,142 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+// TestCacheStatsTracksHitsMissesAndSize 验证Stats()正确反映Load命中/未命中次数
+// 以及当前缓存长度。
+func TestCacheStatsTracksHitsMissesAndSize(t *testing.T) {
+	c := NewCache[string, string](3, 0)
+
+	c.Store("a", "v1")
+	c.Store("b", "v2")
+	c.Load("a")
+	c.Load("a")
+	c.Load("missing")
+
+	s := c.Stats()
+	if s.Hits != 2 {
+		t.Fatalf("expected 2 hits, got %d", s.Hits)
+	}
+	if s.Misses != 1 {
+		t.Fatalf("expected 1 miss, got %d", s.Misses)
+	}
+	if s.Size != 2 {
+		t.Fatalf("expected size 2, got %d", s.Size)
+	}
+}
+
+// TestCacheOnEvictedFiresWithEvictLRUOnCapacityEviction 验证容量淘汰会以
+// EvictLRU原因触发OnEvicted回调，并计入Evictions指标。
+func TestCacheOnEvictedFiresWithEvictLRUOnCapacityEviction(t *testing.T) {
+	var gotKey, gotVal string
+	var gotReason EvictReason
+	fired := 0
+
+	c := NewCache[string, string](3, 0, WithOnEvicted[string, string](func(k, v string, reason EvictReason) {
+		fired++
+		gotKey, gotVal, gotReason = k, v, reason
+	}))
+
+	c.Store("a", "v1")
+	c.Store("b", "v2")
+	c.Store("c", "v3")
+	c.Store("d", "v4") // 容量上限3，触发一次LRU淘汰（a最久未使用）
+
+	if fired != 1 {
+		t.Fatalf("expected OnEvicted to fire exactly once, got %d", fired)
+	}
+	if gotKey != "a" || gotVal != "v1" {
+		t.Fatalf("expected a/v1 to be evicted, got %s/%s", gotKey, gotVal)
+	}
+	if gotReason != EvictLRU {
+		t.Fatalf("expected EvictLRU, got %v", gotReason)
+	}
+	if s := c.Stats(); s.Evictions != 1 {
+		t.Fatalf("expected Evictions=1, got %d", s.Evictions)
+	}
+}
+
+// TestCacheOnEvictedFiresWithEvictManualOnDelete 验证Delete以EvictManual触发回调。
+func TestCacheOnEvictedFiresWithEvictManualOnDelete(t *testing.T) {
+	var gotReason EvictReason
+	fired := 0
+
+	c := NewCache[string, string](3, 0, WithOnEvicted[string, string](func(k, v string, reason EvictReason) {
+		fired++
+		gotReason = reason
+	}))
+
+	c.Store("a", "v1")
+	c.Delete("a")
+
+	if fired != 1 {
+		t.Fatalf("expected OnEvicted to fire exactly once, got %d", fired)
+	}
+	if gotReason != EvictManual {
+		t.Fatalf("expected EvictManual, got %v", gotReason)
+	}
+}
+
+// TestCacheOnEvictedFiresWithEvictClearOnClear 验证Clear对每个被清空的key
+// 以EvictClear触发回调。
+func TestCacheOnEvictedFiresWithEvictClearOnClear(t *testing.T) {
+	reasons := make(map[string]EvictReason)
+
+	c := NewCache[string, string](3, 0, WithOnEvicted[string, string](func(k, v string, reason EvictReason) {
+		reasons[k] = reason
+	}))
+
+	c.Store("a", "v1")
+	c.Store("b", "v2")
+	c.Clear()
+
+	if len(reasons) != 2 {
+		t.Fatalf("expected OnEvicted to fire for both keys, got %d", len(reasons))
+	}
+	for k, r := range reasons {
+		if r != EvictClear {
+			t.Fatalf("expected EvictClear for key %s, got %v", k, r)
+		}
+	}
+}
+
+// TestCacheLoadOrStoreDedupsConcurrentCallsIntoOneStats 验证LoadOrStore命中
+// singleflight去重时，Dedups指标会递增。
+func TestCacheLoadOrStoreDedupsConcurrentCallsIntoOneStats(t *testing.T) {
+	c := NewCache[string, string](3, 0)
+	block := make(chan struct{})
+	started := make(chan struct{})
+	done1 := make(chan struct{})
+	done2 := make(chan struct{})
+
+	go func() {
+		_, _ = c.LoadOrStore("k", func() (string, error) {
+			close(started)
+			<-block
+			return "v", nil
+		})
+		close(done1)
+	}()
+
+	<-started
+	go func() {
+		_, _ = c.LoadOrStore("k", func() (string, error) {
+			t.Error("second LoadOrStore should have been deduped, not called its own loader")
+			return "", nil
+		})
+		close(done2)
+	}()
+	time.Sleep(20 * time.Millisecond) // 留出时间让第二次调用先加入singleflight的in-flight调用
+	close(block)
+	<-done1
+	<-done2
+
+	// singleflight.Do的shared标记所有共享同一次调用结果的caller，既包括实际
+	// 执行loader的那次调用，也包括加入等待的那次，因此两次LoadOrStore都计入Dedups。
+	if s := c.Stats(); s.Dedups != 2 {
+		t.Fatalf("expected Dedups=2 (both the executing and the joining call), got %d", s.Dedups)
+	}
+}
@@ -0,0 +1,187 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTickDuration = time.Second
+	defaultWheelSize    = 60
+)
+
+// wheelEntry 时间轮上挂载的一个key的到期信息。gen记录挂载这条entry时
+// 调用方（Cache）的数据版本号，用于在到期触发时分辨该entry是否仍对应
+// 当前数据，避免Store刷新TTL与旧挂载的到期触发之间出现竞态。
+type wheelEntry[K comparable] struct {
+	key       K
+	expiresAt time.Time
+	gen       uint64
+}
+
+// wheelLoc 记录一个key当前所在的链表及其节点，便于O(1)移除/改道
+type wheelLoc[K comparable] struct {
+	list *list.List
+	elem *list.Element
+}
+
+// timingWheel 哈希时间轮：用固定数量的桶代替每个key一个time.AfterFunc，
+// 由单个后台goroutine每tick推进一格并过期对应的桶，Add/Remove均为O(1)。
+// 到期时间超出一圈能覆盖的范围时，先放入overflow，等时间轮转回起点再迁移进桶中。
+// 参考go-zero collection.Cache的时间轮实现。
+type timingWheel[K comparable] struct {
+	mu        sync.Mutex
+	tick      time.Duration
+	wheelSize int
+	pos       int
+	buckets   []*list.List
+	overflow  *list.List
+	items     map[K]*wheelLoc[K]
+	onExpire  func(K, uint64)
+	ticker    *time.Ticker
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+// newTimingWheel 创建并启动一个时间轮。桶到期时，onExpire携带到期entry挂载时的
+// gen被调用一次；调用方应在执行真正的过期删除前核实gen仍是该key的当前版本。
+func newTimingWheel[K comparable](tick time.Duration, wheelSize int, onExpire func(K, uint64)) *timingWheel[K] {
+	if tick <= 0 {
+		tick = defaultTickDuration
+	}
+	if wheelSize <= 0 {
+		wheelSize = defaultWheelSize
+	}
+	buckets := make([]*list.List, wheelSize)
+	for i := range buckets {
+		buckets[i] = list.New()
+	}
+	w := &timingWheel[K]{
+		tick:      tick,
+		wheelSize: wheelSize,
+		buckets:   buckets,
+		overflow:  list.New(),
+		items:     make(map[K]*wheelLoc[K]),
+		onExpire:  onExpire,
+		ticker:    time.NewTicker(tick),
+		stop:      make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+func (w *timingWheel[K]) run() {
+	for {
+		select {
+		case <-w.stop:
+			w.ticker.Stop()
+			return
+		case <-w.ticker.C:
+			w.advance()
+		}
+	}
+}
+
+// advance 推进一格，过期当前桶中的所有key；走完一整圈时顺带把到期时间已落入
+// 新一圈范围内的overflow条目迁移进对应的桶
+func (w *timingWheel[K]) advance() {
+	w.mu.Lock()
+	bucket := w.buckets[w.pos]
+	var expired []*wheelEntry[K]
+	for e := bucket.Front(); e != nil; {
+		next := e.Next()
+		entry := e.Value.(*wheelEntry[K])
+		expired = append(expired, entry)
+		bucket.Remove(e)
+		delete(w.items, entry.key)
+		e = next
+	}
+	w.pos = (w.pos + 1) % w.wheelSize
+	if w.pos == 0 {
+		w.promoteOverflowLocked(time.Now())
+	}
+	w.mu.Unlock()
+
+	for _, entry := range expired {
+		w.onExpire(entry.key, entry.gen)
+	}
+}
+
+func (w *timingWheel[K]) promoteOverflowLocked(now time.Time) {
+	horizon := now.Add(time.Duration(w.wheelSize) * w.tick)
+	for e := w.overflow.Front(); e != nil; {
+		next := e.Next()
+		entry := e.Value.(*wheelEntry[K])
+		if entry.expiresAt.Before(horizon) {
+			w.overflow.Remove(e)
+			w.insertLocked(entry, now)
+		}
+		e = next
+	}
+}
+
+// insertLocked 调用方需持有mu，根据到期时间落入具体的桶或overflow
+func (w *timingWheel[K]) insertLocked(entry *wheelEntry[K], now time.Time) {
+	offset := entry.expiresAt.Sub(now)
+	ticks := int(offset / w.tick)
+	if ticks >= w.wheelSize {
+		elem := w.overflow.PushBack(entry)
+		w.items[entry.key] = &wheelLoc[K]{list: w.overflow, elem: elem}
+		return
+	}
+	if ticks < 0 {
+		ticks = 0
+	}
+	idx := (w.pos + ticks) % w.wheelSize
+	bucket := w.buckets[idx]
+	elem := bucket.PushBack(entry)
+	w.items[entry.key] = &wheelLoc[K]{list: bucket, elem: elem}
+}
+
+// Add 挂载/重置一个key的过期时间，gen应为调用方当前这份数据的版本号，
+// 到期触发时会原样传回，供调用方核实该次到期是否仍针对这份数据。
+// 调用方可能在gmutex之外并发调用Add（如Cache.Store），导致两次Add以gen逆序
+// 到达这里；此时若直接覆盖，会让新挂载被旧挂载换掉、新gen的entry丢失并永远
+// 不再到期。因此gen小于当前已挂载gen的调用视为过期的重复调用，直接丢弃。
+func (w *timingWheel[K]) Add(key K, ttl time.Duration, gen uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if loc, ok := w.items[key]; ok {
+		if existing := loc.elem.Value.(*wheelEntry[K]); gen < existing.gen {
+			return
+		}
+		loc.list.Remove(loc.elem)
+		delete(w.items, key)
+	}
+	now := time.Now()
+	w.insertLocked(&wheelEntry[K]{key: key, expiresAt: now.Add(ttl), gen: gen}, now)
+}
+
+// Remove 主动移除一个key的过期挂载
+func (w *timingWheel[K]) Remove(key K) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if loc, ok := w.items[key]; ok {
+		loc.list.Remove(loc.elem)
+		delete(w.items, key)
+	}
+}
+
+// Clear 清空时间轮上的全部挂载
+func (w *timingWheel[K]) Clear() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, b := range w.buckets {
+		b.Init()
+	}
+	w.overflow.Init()
+	w.items = make(map[K]*wheelLoc[K])
+}
+
+// Stop 停止时间轮的后台goroutine
+func (w *timingWheel[K]) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stop)
+	})
+}
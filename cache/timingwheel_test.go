@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestTimingWheelAdvanceExpiresBucket 验证tick推进到某个key所在的桶时，
+// onExpire被调用且携带该key挂载时的gen。
+func TestTimingWheelAdvanceExpiresBucket(t *testing.T) {
+	var mu sync.Mutex
+	fired := make(map[string]uint64)
+
+	w := newTimingWheel[string](10*time.Millisecond, 8, func(k string, gen uint64) {
+		mu.Lock()
+		fired[k] = gen
+		mu.Unlock()
+	})
+	defer w.Stop()
+
+	w.Add("a", 15*time.Millisecond, 7)
+
+	deadline := time.Now().Add(500 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		_, ok := fired["a"]
+		mu.Unlock()
+		if ok {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	mu.Lock()
+	gen, ok := fired["a"]
+	mu.Unlock()
+	if !ok {
+		t.Fatalf("expected onExpire to fire for key a")
+	}
+	if gen != 7 {
+		t.Fatalf("expected gen=7 to be passed through, got %d", gen)
+	}
+}
+
+// TestTimingWheelAddResetsExistingMount 验证对同一个key重复Add会取消旧挂载，
+// 只按最新一次的ttl/gen过期一次。
+func TestTimingWheelAddResetsExistingMount(t *testing.T) {
+	var mu sync.Mutex
+	var fireCount int
+	var lastGen uint64
+
+	w := newTimingWheel[string](10*time.Millisecond, 8, func(k string, gen uint64) {
+		mu.Lock()
+		fireCount++
+		lastGen = gen
+		mu.Unlock()
+	})
+	defer w.Stop()
+
+	w.Add("a", 200*time.Millisecond, 1)
+	w.Add("a", 15*time.Millisecond, 2)
+
+	deadline := time.Now().Add(500 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		n := fireCount
+		mu.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	mu.Lock()
+	n, gen := fireCount, lastGen
+	mu.Unlock()
+	if n != 1 {
+		t.Fatalf("expected exactly one firing after re-Add, got %d", n)
+	}
+	if gen != 2 {
+		t.Fatalf("expected the latest gen=2 to fire, got %d", gen)
+	}
+}
+
+// TestTimingWheelOverflowPromotion 验证ttl超出一圈覆盖范围的entry先落入overflow，
+// 时间轮转回起点后被迁移进对应的桶并最终正常过期。
+func TestTimingWheelOverflowPromotion(t *testing.T) {
+	var mu sync.Mutex
+	fired := false
+
+	const wheelSize = 4
+	const tick = 10 * time.Millisecond
+
+	w := newTimingWheel[string](tick, wheelSize, func(k string, gen uint64) {
+		mu.Lock()
+		fired = true
+		mu.Unlock()
+	})
+	defer w.Stop()
+
+	// ttl跨越的格数超过wheelSize，必然先进入overflow
+	w.Add("a", time.Duration(wheelSize+2)*tick, 1)
+
+	w.mu.Lock()
+	_, inOverflow := w.items["a"]
+	onOverflowList := w.overflow.Len() == 1
+	w.mu.Unlock()
+	if !inOverflow || !onOverflowList {
+		t.Fatalf("expected key a to be parked on overflow list initially")
+	}
+
+	deadline := time.Now().Add(1 * time.Second)
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		f := fired
+		mu.Unlock()
+		if f {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	mu.Lock()
+	f := fired
+	mu.Unlock()
+	if !f {
+		t.Fatalf("expected overflow entry to eventually be promoted and expire")
+	}
+}
+
+// TestTimingWheelAddRejectsStaleGen 针对chunk0-4的回归测试：模拟两次Add以gen逆序
+// 到达的情形，验证持有较小gen的调用不会把已经挂载的较大gen的entry换掉。
+func TestTimingWheelAddRejectsStaleGen(t *testing.T) {
+	w := newTimingWheel[string](time.Hour, 8, func(k string, gen uint64) {})
+	defer w.Stop()
+
+	w.Add("k", time.Minute, 2)
+	w.Add("k", time.Minute, 1) // gen=1晚到，应被丢弃而不是覆盖gen=2
+
+	w.mu.Lock()
+	loc, ok := w.items["k"]
+	var gen uint64
+	if ok {
+		gen = loc.elem.Value.(*wheelEntry[string]).gen
+	}
+	w.mu.Unlock()
+
+	if !ok {
+		t.Fatalf("expected k to still be mounted")
+	}
+	if gen != 2 {
+		t.Fatalf("expected the authoritative gen=2 mount to survive, got gen=%d", gen)
+	}
+}